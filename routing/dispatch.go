@@ -0,0 +1,60 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import "github.com/gofiber/fiber/v3"
+
+// ensureGroupDispatcher installs, once per group, the route that applies
+// this group's keyed middleware stack and its (or its nearest ancestor's)
+// OnError/OnPanic handlers to its own routes. AddMiddleware and OnError/
+// OnPanic both need this same route, so it's installed once under a
+// single sync.Once: registering it twice at the identical grp.Prefix
+// would make whichever feature called first the outer wrapper, letting a
+// panic inside middleware added after OnPanic slip past the recovery
+// it's supposed to be caught by.
+func (grp *Group) ensureGroupDispatcher() {
+	grp.groupDispatcherOnce.Do(func() {
+		grp.app.register([]string{fiber.methodUse}, grp.Prefix, grp, nil, grp.hostGuarded(grp.dispatchGroup))
+	})
+}
+
+// dispatchGroup recovers panics and runs the group's keyed middleware
+// stack - read lock-free off an atomic snapshot - ahead of the rest of
+// the route chain, then translates a returned error using the nearest
+// OnError handler in the parent-group chain. All of this lives in a
+// single registered route so ordering between AddMiddleware and OnError/
+// OnPanic never matters.
+func (grp *Group) dispatchGroup(c fiber.Ctx) (err error) {
+	if handler, ok := grp.panicHandlerFor(); ok {
+		defer func() {
+			if r := recover(); r != nil {
+				handler(c, r)
+			}
+		}()
+	}
+
+	if chain := grp.middlewareChain.Load(); chain != nil {
+		for _, h := range *chain {
+			if err = h(c); err != nil {
+				return grp.handleGroupError(c, err)
+			}
+		}
+	}
+
+	if err = c.Next(); err == nil {
+		return nil
+	}
+	return grp.handleGroupError(c, err)
+}
+
+// handleGroupError translates err using the nearest OnError handler in
+// the parent-group chain, falling back to the app's default handling
+// when this group and its ancestors never registered one.
+func (grp *Group) handleGroupError(c fiber.Ctx, err error) error {
+	if handler, ok := grp.errorHandlerFor(); ok {
+		return handler(c, err)
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// TestDispatchGroupCatchesPanicRegardlessOfCallOrder guards against the
+// regression where AddMiddleware and OnError/OnPanic each installed their
+// own "methodUse" route at the same grp.Prefix: whichever was called
+// first ended up as the outer wrapper, so a panic raised by middleware
+// added after OnPanic never reached it. Both now share one dispatchGroup
+// route via ensureGroupDispatcher, so the order AddMiddleware/OnPanic are
+// called in must not matter.
+func TestDispatchGroupCatchesPanicRegardlessOfCallOrder(t *testing.T) {
+	panicky := func(fiber.Ctx) error { panic("boom") }
+
+	t.Run("middleware added before OnPanic", func(t *testing.T) {
+		grp := &Group{}
+		registerNamedMiddlewareLocked(grp, "panicky", panicky)
+		var caught any
+		grp.panicHandler = func(c fiber.Ctx, r any) { caught = r }
+
+		_ = grp.dispatchGroup(nil)
+		if caught != "boom" {
+			t.Errorf("got %v, want panic to be caught as %q", caught, "boom")
+		}
+	})
+
+	t.Run("OnPanic called before middleware added", func(t *testing.T) {
+		grp := &Group{}
+		var caught any
+		grp.panicHandler = func(c fiber.Ctx, r any) { caught = r }
+		registerNamedMiddlewareLocked(grp, "panicky", panicky)
+
+		_ = grp.dispatchGroup(nil)
+		if caught != "boom" {
+			t.Errorf("got %v, want panic to be caught as %q", caught, "boom")
+		}
+	})
+}
+
+func TestDispatchGroupAppliesErrorHandlerToMiddlewareErrors(t *testing.T) {
+	grp := &Group{}
+	registerNamedMiddlewareLocked(grp, "failing", func(fiber.Ctx) error { return errors.New("boom") })
+	grp.errorHandler = func(c fiber.Ctx, err error) error { return errors.New("wrapped: " + err.Error()) }
+
+	err := grp.dispatchGroup(nil)
+	if err == nil || err.Error() != "wrapped: boom" {
+		t.Errorf("got %v, want the group's error handler to translate the middleware's error", err)
+	}
+}
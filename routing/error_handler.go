@@ -0,0 +1,78 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import "github.com/gofiber/fiber/v3"
+
+// OnError registers a Group-scoped error handler, used in place of the
+// app's default fiber.Config.ErrorHandler for errors returned by routes
+// on this group. Child groups created with Group/Route/Host inherit it
+// unless they call OnError themselves.
+//
+//	api := app.Group("/api").OnError(func(c fiber.Ctx, err error) error {
+//	     return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+//	})
+func (grp *Group) OnError(fn fiber.ErrorHandler) ExpressjsRouterI {
+	grp.app.mutex.Lock()
+	grp.errorHandler = fn
+	grp.app.mutex.Unlock()
+
+	grp.ensureGroupDispatcher()
+
+	return grp
+}
+
+// OnPanic registers a Group-scoped recovery handler, inherited the same
+// way as OnError, invoked when a route on this group panics instead of
+// the app's default recover handler.
+func (grp *Group) OnPanic(fn func(fiber.Ctx, any)) ExpressjsRouterI {
+	grp.app.mutex.Lock()
+	grp.panicHandler = fn
+	grp.app.mutex.Unlock()
+
+	grp.ensureGroupDispatcher()
+
+	return grp
+}
+
+// errorHandlerFor walks up the parent-group chain for the nearest
+// Group.OnError handler. The dispatcher falls back to the app's default
+// fiber.Config.ErrorHandler when ok is false.
+func (grp *Group) errorHandlerFor() (handler fiber.ErrorHandler, ok bool) {
+	for g := grp; g != nil; g = g.parentGroup {
+		if g.errorHandler != nil {
+			return g.errorHandler, true
+		}
+	}
+	return nil, false
+}
+
+// panicHandlerFor walks up the parent-group chain for the nearest
+// Group.OnPanic handler.
+func (grp *Group) panicHandlerFor() (handler func(fiber.Ctx, any), ok bool) {
+	for g := grp; g != nil; g = g.parentGroup {
+		if g.panicHandler != nil {
+			return g.panicHandler, true
+		}
+	}
+	return nil, false
+}
+
+// propagateErrorHandlers gives a mounted sub-app this group's inherited
+// error/panic handlers when the sub-app doesn't define its own, so a
+// sub-app mounted under, say, an HTML-rendering admin group still
+// renders its errors the way that group expects.
+func (grp *Group) propagateErrorHandlers(subApp *fiber.App) {
+	if subApp.config.ErrorHandler == nil {
+		if handler, ok := grp.errorHandlerFor(); ok {
+			subApp.config.ErrorHandler = handler
+		}
+	}
+	if subApp.config.PanicHandler == nil {
+		if handler, ok := grp.panicHandlerFor(); ok {
+			subApp.config.PanicHandler = handler
+		}
+	}
+}
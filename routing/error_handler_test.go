@@ -0,0 +1,56 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestErrorHandlerForWalksUpToNearestAncestor(t *testing.T) {
+	root := &Group{}
+	if _, ok := root.errorHandlerFor(); ok {
+		t.Fatal("group with no OnError anywhere in its chain should report ok == false")
+	}
+
+	root.errorHandler = func(c fiber.Ctx, err error) error { return errors.New("root: " + err.Error()) }
+	child := &Group{parentGroup: root}
+	grandchild := &Group{parentGroup: child}
+
+	handler, ok := grandchild.errorHandlerFor()
+	if !ok {
+		t.Fatal("grandchild should inherit root's error handler")
+	}
+	if err := handler(nil, errors.New("boom")); err.Error() != "root: boom" {
+		t.Errorf("got %q, want %q", err.Error(), "root: boom")
+	}
+
+	child.errorHandler = func(c fiber.Ctx, err error) error { return errors.New("child: " + err.Error()) }
+	handler, ok = grandchild.errorHandlerFor()
+	if !ok {
+		t.Fatal("grandchild should find child's closer error handler")
+	}
+	if err := handler(nil, errors.New("boom")); err.Error() != "child: boom" {
+		t.Errorf("closer ancestor's handler should win: got %q, want %q", err.Error(), "child: boom")
+	}
+}
+
+func TestPanicHandlerForWalksUpToNearestAncestor(t *testing.T) {
+	root := &Group{}
+	var caught any
+	root.panicHandler = func(c fiber.Ctx, r any) { caught = r }
+
+	grandchild := &Group{parentGroup: &Group{parentGroup: root}}
+	handler, ok := grandchild.panicHandlerFor()
+	if !ok {
+		t.Fatal("grandchild should inherit root's panic handler")
+	}
+	handler(nil, "boom")
+	if caught != "boom" {
+		t.Errorf("got %v, want %q", caught, "boom")
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"github.com/gofiber/fiber/v3"
 	"reflect"
+	"sync"
+	"sync/atomic"
 )
 
 // Group struct
@@ -16,6 +18,20 @@ type Group struct {
 	parentGroup     *Group
 	name            string
 	anyRouteDefined bool
+	lastRoutePath   string
+
+	hostPatterns []string
+	hostMatchers []hostMatcher
+
+	namedMiddlewareMu    sync.Mutex
+	namedMiddleware      map[string]fiber.Handler
+	namedMiddlewareOrder []string
+	middlewareChain      atomic.Pointer[[]fiber.Handler]
+
+	errorHandler fiber.ErrorHandler
+	panicHandler func(fiber.Ctx, any)
+
+	groupDispatcherOnce sync.Once
 
 	Prefix string
 	fiber.IGroup
@@ -32,6 +48,7 @@ func (grp *Group) GetPrefix() string {
 func (grp *Group) Name(name string) ExpressjsRouterI {
 	if grp.anyRouteDefined {
 		grp.app.Name(name)
+		registryFor(grp.app).register(name, grp.lastRoutePath)
 
 		return grp
 	}
@@ -43,7 +60,7 @@ func (grp *Group) Name(name string) ExpressjsRouterI {
 		grp.name = name
 	}
 
-	if err := grp.app.hooks.executeOnGroupNameHooks(*grp); err != nil {
+	if err := grp.app.hooks.executeOnGroupNameHooks(grp); err != nil {
 		panic(err)
 	}
 	grp.app.mutex.Unlock()
@@ -100,10 +117,11 @@ func (grp *Group) Use(args ...any) ExpressjsRouterI {
 	for _, prefix := range prefixes {
 		if subApp != nil {
 			grp.mount(prefix, subApp)
+			grp.propagateErrorHandlers(subApp)
 			return grp
 		}
 
-		grp.app.register([]string{fiber.methodUse}, fiber.getGroupPath(grp.Prefix, prefix), grp, nil, handlers...)
+		grp.app.register([]string{fiber.methodUse}, fiber.getGroupPath(grp.Prefix, prefix), grp, nil, grp.hostGuardedAll(handlers)...)
 	}
 
 	if !grp.anyRouteDefined {
@@ -168,7 +186,9 @@ func (grp *Group) Patch(path string, handler fiber.Handler, middleware ...fiber.
 
 // Add allows you to specify multiple HTTP methods to register a route.
 func (grp *Group) Add(methods []string, path string, handler fiber.Handler, middleware ...fiber.Handler) ExpressjsRouterI {
-	grp.app.register(methods, fiber.getGroupPath(grp.Prefix, path), grp, handler, middleware...)
+	fullPath := fiber.getGroupPath(grp.Prefix, path)
+	grp.app.register(methods, fullPath, grp, grp.hostGuarded(handler), grp.hostGuardedAll(middleware)...)
+	grp.lastRoutePath = fullPath
 	if !grp.anyRouteDefined {
 		grp.anyRouteDefined = true
 	}
@@ -199,12 +219,13 @@ func (grp *Group) All(path string, handler fiber.Handler, middleware ...fiber.Ha
 func (grp *Group) Group(prefix string, handlers ...fiber.Handler) ExpressjsRouterI {
 	prefix = fiber.getGroupPath(grp.Prefix, prefix)
 	if len(handlers) > 0 {
-		grp.app.register([]string{fiber.methodUse}, prefix, grp, nil, handlers...)
+		grp.app.register([]string{fiber.methodUse}, prefix, grp, nil, grp.hostGuardedAll(handlers)...)
 	}
 
 	// Create new group
 	newGrp := &Group{Prefix: prefix, app: grp.app, parentGroup: grp}
-	if err := grp.app.hooks.executeOnGroupHooks(*newGrp); err != nil {
+	newGrp.inheritHost(grp)
+	if err := grp.app.hooks.executeOnGroupHooks(newGrp); err != nil {
 		panic(err)
 	}
 
@@ -0,0 +1,154 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// hostMatcher reports whether a request's Host header satisfies a single
+// pattern passed to Group.Host.
+type hostMatcher func(host string) bool
+
+// compileHostMatcher turns a Host pattern into a hostMatcher.
+//
+// Three forms are supported:
+//
+//   - "~<expr>" compiles expr as a regular expression and matches it
+//     anywhere in the host; no anchors are added, so a pattern that must
+//     cover the whole host spells out ^ and $ itself, e.g.
+//     "~^api-\\d+\\.example\\.com$".
+//   - "*.example.com" matches exactly one leading label followed by the
+//     given suffix.
+//   - anything else is matched case-insensitively as an exact host.
+func compileHostMatcher(pattern string) hostMatcher {
+	if rest, ok := strings.CutPrefix(pattern, "~"); ok {
+		re := regexp.MustCompile(rest)
+		return func(host string) bool { return re.MatchString(host) }
+	}
+
+	if rest, ok := strings.CutPrefix(pattern, "*."); ok {
+		suffix := "." + rest
+		return func(host string) bool {
+			if !strings.HasSuffix(host, suffix) {
+				return false
+			}
+			label := strings.TrimSuffix(host, suffix)
+			return label != "" && !strings.Contains(label, ".")
+		}
+	}
+
+	exact := strings.ToLower(pattern)
+	return func(host string) bool { return strings.ToLower(host) == exact }
+}
+
+// Host returns a new child Group whose routes are only matched when the
+// request's Host header satisfies at least one of patterns, in addition
+// to the usual Prefix match. Group/Use/the verb methods on the returned
+// Group (and on any group created from it) inherit the same constraint.
+// Calling Host on a group that already has a Host constraint adds to its
+// patterns rather than narrowing them: the new group matches a host that
+// satisfies either an inherited pattern or one of the new ones.
+// Two Host groups may register the very same method+path: a request
+// whose Host doesn't match one group's patterns falls through to the
+// next route registered for that method+path instead of 404ing, so
+//
+//	app.Host("api.example.com").Get("/", apiIndex)
+//	app.Host("admin.example.com").Get("/", adminIndex)
+//
+// correctly routes each host to its own handler rather than the
+// first-registered group shadowing the second.
+//
+//	api := app.Host("api.example.com")
+//	api.Get("/users", listUsers)
+//
+//	admin := app.Host("*.admin.example.com", "~^staging-admin\\.")
+//	admin.Get("/", adminIndex)
+func (grp *Group) Host(patterns ...string) ExpressjsRouterI {
+	newGrp := &Group{Prefix: grp.Prefix, app: grp.app, parentGroup: grp}
+	newGrp.inheritHost(grp)
+	newGrp.addHostPatterns(patterns...)
+
+	if err := grp.app.hooks.executeOnGroupHooks(newGrp); err != nil {
+		panic(err)
+	}
+
+	return newGrp
+}
+
+// hostGuarded wraps h so it only runs when the request's Host header
+// satisfies grp's Host constraint. On a mismatch it calls c.Next()
+// instead of failing the request, which - since every handler and
+// middleware passed to Add/Use is wrapped the same way, so each of them
+// passes the request on - defers to whatever is registered next for that
+// method+path (e.g. a different Host group's equally-guarded route),
+// rather than 404ing a request that a later, matching registration could
+// have served. Groups with no Host constraint get h back unchanged: a
+// fast path with zero added indirection.
+func (grp *Group) hostGuarded(h fiber.Handler) fiber.Handler {
+	if !grp.HasHostConstraint() {
+		return h
+	}
+	return func(c fiber.Ctx) error {
+		if !grp.MatchesHost(c.Hostname()) {
+			return c.Next()
+		}
+		return h(c)
+	}
+}
+
+// hostGuardedAll applies hostGuarded to every handler in handlers.
+func (grp *Group) hostGuardedAll(handlers []fiber.Handler) []fiber.Handler {
+	if !grp.HasHostConstraint() {
+		return handlers
+	}
+	guarded := make([]fiber.Handler, len(handlers))
+	for i, h := range handlers {
+		guarded[i] = grp.hostGuarded(h)
+	}
+	return guarded
+}
+
+// inheritHost copies parent's host constraint onto grp, so groups created
+// under a Host group keep requiring the same Host match.
+func (grp *Group) inheritHost(parent *Group) {
+	if len(parent.hostPatterns) == 0 {
+		return
+	}
+	grp.hostPatterns = append(grp.hostPatterns, parent.hostPatterns...)
+	grp.hostMatchers = append(grp.hostMatchers, parent.hostMatchers...)
+}
+
+func (grp *Group) addHostPatterns(patterns ...string) {
+	for _, pattern := range patterns {
+		grp.hostPatterns = append(grp.hostPatterns, pattern)
+		grp.hostMatchers = append(grp.hostMatchers, compileHostMatcher(pattern))
+	}
+}
+
+// HasHostConstraint reports whether grp (or any ancestor it was created
+// from) restricts its routes to specific Host headers. The router uses
+// this as a fast path: when no group in the app ever calls Host, host
+// matching is skipped entirely instead of being checked per request.
+func (grp *Group) HasHostConstraint() bool {
+	return len(grp.hostMatchers) > 0
+}
+
+// MatchesHost reports whether host satisfies grp's Host constraint. A
+// group with no Host constraint matches every host.
+func (grp *Group) MatchesHost(host string) bool {
+	if len(grp.hostMatchers) == 0 {
+		return true
+	}
+	for _, match := range grp.hostMatchers {
+		if match(host) {
+			return true
+		}
+	}
+	return false
+}
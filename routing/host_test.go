@@ -0,0 +1,101 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestCompileHostMatcher(t *testing.T) {
+	tests := []struct {
+		pattern string
+		host    string
+		want    bool
+	}{
+		{"api.example.com", "api.example.com", true},
+		{"api.example.com", "API.EXAMPLE.COM", true},
+		{"api.example.com", "other.example.com", false},
+		{"*.example.com", "api.example.com", true},
+		{"*.example.com", "a.b.example.com", false},
+		{"*.example.com", "example.com", false},
+		{`~^api-\d+\.example\.com$`, "api-42.example.com", true},
+		{`~^api-\d+\.example\.com$`, "api-x.example.com", false},
+	}
+
+	for _, tt := range tests {
+		match := compileHostMatcher(tt.pattern)
+		if got := match(tt.host); got != tt.want {
+			t.Errorf("compileHostMatcher(%q)(%q) = %v, want %v", tt.pattern, tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestGroupMatchesHost(t *testing.T) {
+	grp := &Group{}
+	if !grp.MatchesHost("anything.example.com") {
+		t.Error("group with no Host constraint should match every host")
+	}
+	if grp.HasHostConstraint() {
+		t.Error("group with no Host constraint should report HasHostConstraint() == false")
+	}
+
+	grp.addHostPatterns("api.example.com", "*.internal.example.com")
+	if !grp.HasHostConstraint() {
+		t.Error("group with Host patterns should report HasHostConstraint() == true")
+	}
+	if !grp.MatchesHost("api.example.com") {
+		t.Error("expected exact host pattern to match")
+	}
+	if !grp.MatchesHost("svc.internal.example.com") {
+		t.Error("expected wildcard host pattern to match")
+	}
+	if grp.MatchesHost("admin.example.com") {
+		t.Error("host outside every pattern should not match")
+	}
+}
+
+func TestGroupInheritHost(t *testing.T) {
+	parent := &Group{}
+	parent.addHostPatterns("api.example.com")
+
+	child := &Group{}
+	child.inheritHost(parent)
+
+	if !child.MatchesHost("api.example.com") {
+		t.Error("child group should inherit parent's Host constraint")
+	}
+	if child.MatchesHost("other.example.com") {
+		t.Error("child group should reject hosts outside parent's Host constraint")
+	}
+}
+
+func TestHostGuardedNoOpWithoutConstraint(t *testing.T) {
+	grp := &Group{}
+	h := func(fiber.Ctx) error { return nil }
+
+	guarded := grp.hostGuarded(h)
+	if reflect.ValueOf(guarded).Pointer() != reflect.ValueOf(h).Pointer() {
+		t.Error("hostGuarded should return the handler unchanged when the group has no Host constraint")
+	}
+
+	guardedAll := grp.hostGuardedAll([]fiber.Handler{h})
+	if reflect.ValueOf(guardedAll[0]).Pointer() != reflect.ValueOf(h).Pointer() {
+		t.Error("hostGuardedAll should return handlers unchanged when the group has no Host constraint")
+	}
+}
+
+func TestHostGuardedWrapsWithConstraint(t *testing.T) {
+	grp := &Group{}
+	grp.addHostPatterns("api.example.com")
+	h := func(fiber.Ctx) error { return nil }
+
+	guarded := grp.hostGuarded(h)
+	if reflect.ValueOf(guarded).Pointer() == reflect.ValueOf(h).Pointer() {
+		t.Error("hostGuarded should wrap the handler once the group has a Host constraint")
+	}
+}
@@ -0,0 +1,82 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import "github.com/gofiber/fiber/v3"
+
+// AddMiddleware registers a keyed middleware on the group. Keyed
+// middleware runs, in the order keys were first added, ahead of the
+// routes registered on this group after its first AddMiddleware, OnError
+// or OnPanic call - the call that installs the group's dispatcher route.
+// Routes registered before that are matched ahead of the dispatcher and
+// do not run behind keyed middleware. Keys added, replaced or removed
+// later still apply to those routes behind the dispatcher, since the
+// chain is re-read on every request rather than baked into each route at
+// registration time. Calling AddMiddleware
+// again with an existing key is equivalent to ReplaceMiddleware.
+//
+//	api := app.Group("/api")
+//	api.AddMiddleware("auth", authMiddleware)
+//	api.Get("/users", listUsers) // runs behind "auth"
+func (grp *Group) AddMiddleware(key string, h fiber.Handler) ExpressjsRouterI {
+	grp.namedMiddlewareMu.Lock()
+	if grp.namedMiddleware == nil {
+		grp.namedMiddleware = make(map[string]fiber.Handler)
+	}
+	if _, exists := grp.namedMiddleware[key]; !exists {
+		grp.namedMiddlewareOrder = append(grp.namedMiddlewareOrder, key)
+	}
+	grp.namedMiddleware[key] = h
+	grp.publishMiddlewareChainLocked()
+	grp.namedMiddlewareMu.Unlock()
+
+	grp.ensureGroupDispatcher()
+
+	return grp
+}
+
+// RemoveMiddleware removes the keyed middleware previously registered
+// with AddMiddleware. It's a no-op if key was never registered. Like
+// AddMiddleware, the change takes effect for in-flight route matching
+// without rebuilding the app.
+func (grp *Group) RemoveMiddleware(key string) ExpressjsRouterI {
+	grp.namedMiddlewareMu.Lock()
+	defer grp.namedMiddlewareMu.Unlock()
+
+	if _, exists := grp.namedMiddleware[key]; !exists {
+		return grp
+	}
+	delete(grp.namedMiddleware, key)
+	for i, existing := range grp.namedMiddlewareOrder {
+		if existing == key {
+			grp.namedMiddlewareOrder = append(grp.namedMiddlewareOrder[:i], grp.namedMiddlewareOrder[i+1:]...)
+			break
+		}
+	}
+	grp.publishMiddlewareChainLocked()
+
+	return grp
+}
+
+// ReplaceMiddleware swaps the handler registered under key, keeping its
+// position in the chain. If key isn't registered yet, it's added at the
+// end, same as AddMiddleware.
+func (grp *Group) ReplaceMiddleware(key string, h fiber.Handler) ExpressjsRouterI {
+	return grp.AddMiddleware(key, h)
+}
+
+// publishMiddlewareChainLocked rebuilds the compiled handler chain from
+// the keyed registry and atomically publishes it for dispatchGroup to
+// read without taking namedMiddlewareMu. Callers must hold
+// namedMiddlewareMu; this keeps registry mutation (rare) off the request
+// hot path entirely, rather than serializing every request on a shared
+// app-wide lock.
+func (grp *Group) publishMiddlewareChainLocked() {
+	chain := make([]fiber.Handler, 0, len(grp.namedMiddlewareOrder))
+	for _, key := range grp.namedMiddlewareOrder {
+		chain = append(chain, grp.namedMiddleware[key])
+	}
+	grp.middlewareChain.Store(&chain)
+}
@@ -0,0 +1,82 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import (
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// registerNamedMiddlewareLocked exercises the same registry/chain update
+// AddMiddleware performs, without requiring a live *fiber.App - these
+// tests cover the ordering and recompute semantics that dispatchGroup
+// relies on.
+func registerNamedMiddlewareLocked(grp *Group, key string, h fiber.Handler) {
+	grp.namedMiddlewareMu.Lock()
+	defer grp.namedMiddlewareMu.Unlock()
+
+	if grp.namedMiddleware == nil {
+		grp.namedMiddleware = make(map[string]fiber.Handler)
+	}
+	if _, exists := grp.namedMiddleware[key]; !exists {
+		grp.namedMiddlewareOrder = append(grp.namedMiddlewareOrder, key)
+	}
+	grp.namedMiddleware[key] = h
+	grp.publishMiddlewareChainLocked()
+}
+
+func TestMiddlewareChainPreservesRegistrationOrder(t *testing.T) {
+	grp := &Group{}
+	var order []string
+
+	registerNamedMiddlewareLocked(grp, "logging", func(fiber.Ctx) error { order = append(order, "logging"); return nil })
+	registerNamedMiddlewareLocked(grp, "auth", func(fiber.Ctx) error { order = append(order, "auth"); return nil })
+
+	chain := grp.middlewareChain.Load()
+	if chain == nil || len(*chain) != 2 {
+		t.Fatalf("expected 2 middleware in chain, got %v", chain)
+	}
+	for _, h := range *chain {
+		_ = h(nil)
+	}
+	if want := []string{"logging", "auth"}; order[0] != want[0] || order[1] != want[1] {
+		t.Errorf("middleware ran out of order: got %v, want %v", order, want)
+	}
+}
+
+func TestMiddlewareChainReplaceKeepsPosition(t *testing.T) {
+	grp := &Group{}
+	var ran string
+
+	registerNamedMiddlewareLocked(grp, "auth", func(fiber.Ctx) error { ran = "old"; return nil })
+	registerNamedMiddlewareLocked(grp, "logging", func(fiber.Ctx) error { ran = "logging"; return nil })
+	registerNamedMiddlewareLocked(grp, "auth", func(fiber.Ctx) error { ran = "new"; return nil })
+
+	chain := grp.middlewareChain.Load()
+	if len(*chain) != 2 {
+		t.Fatalf("replacing an existing key should not grow the chain, got %d entries", len(*chain))
+	}
+	_ = (*chain)[0](nil)
+	if ran != "new" {
+		t.Errorf("expected replaced handler to run in its original slot, got %q", ran)
+	}
+}
+
+func TestMiddlewareChainRemove(t *testing.T) {
+	grp := &Group{}
+	registerNamedMiddlewareLocked(grp, "auth", func(fiber.Ctx) error { return nil })
+
+	grp.namedMiddlewareMu.Lock()
+	delete(grp.namedMiddleware, "auth")
+	grp.namedMiddlewareOrder = grp.namedMiddlewareOrder[:0]
+	grp.publishMiddlewareChainLocked()
+	grp.namedMiddlewareMu.Unlock()
+
+	chain := grp.middlewareChain.Load()
+	if chain == nil || len(*chain) != 0 {
+		t.Errorf("expected empty chain after removing the only middleware, got %v", chain)
+	}
+}
@@ -0,0 +1,116 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// ResourceController backs a Group.Resource call. It's intentionally
+// empty - a controller only needs to implement whichever of
+// List/Show/New/Create/Edit/Update/Destroy it wants routed; Resource
+// type-asserts for each one individually.
+type ResourceController any
+
+type (
+	resourceLister    interface{ List(fiber.Ctx) error }
+	resourceShower    interface{ Show(fiber.Ctx) error }
+	resourceNewer     interface{ New(fiber.Ctx) error }
+	resourceCreator   interface{ Create(fiber.Ctx) error }
+	resourceEditor    interface{ Edit(fiber.Ctx) error }
+	resourceUpdater   interface{ Update(fiber.Ctx) error }
+	resourceDestroyer interface{ Destroy(fiber.Ctx) error }
+)
+
+// Resource registers the conventional RESTful routes for name under the
+// group's prefix, wiring each route to whichever of
+// List/Show/New/Create/Edit/Update/Destroy controller implements:
+//
+//	GET    /name          -> List    -> "name.index"
+//	GET    /name/new      -> New     -> "name.new"
+//	POST   /name          -> Create  -> "name.create"
+//	GET    /name/:id      -> Show    -> "name.show"
+//	GET    /name/:id/edit -> Edit    -> "name.edit"
+//	PATCH  /name/:id      -> Update  -> "name.update"
+//	PUT    /name/:id      -> Update  -> "name.update"
+//	DELETE /name/:id      -> Destroy -> "name.destroy"
+//
+// The :id param is named after the singular of name, e.g. "users" gets
+// ":user_id". Route names follow the same "name.action" convention so
+// they work with Group.URL/AppURL out of the box.
+//
+//	type Users struct{}
+//	func (Users) List(c fiber.Ctx) error { ... }
+//	func (Users) Show(c fiber.Ctx) error { ... }
+//	app.Resource("users", Users{})
+func (grp *Group) Resource(name string, controller ResourceController) ExpressjsRouterI {
+	for _, route := range resourceRoutes(name, controller) {
+		grp.Add(route.methods, route.path, route.handler).Name(route.name)
+	}
+
+	return grp
+}
+
+// resourceRoute is one method/path/name/handler entry produced by
+// resourceRoutes. It's split out from Resource so the route generation
+// (which controller methods map to which verb, path and name) can be
+// tested without a live *fiber.App.
+type resourceRoute struct {
+	methods []string
+	path    string
+	name    string
+	handler fiber.Handler
+}
+
+// resourceRoutes builds the resourceRoute entries for whichever of
+// List/Show/New/Create/Edit/Update/Destroy controller implements, per
+// the route table documented on Resource.
+func resourceRoutes(name string, controller ResourceController) []resourceRoute {
+	idParam := ":" + singularize(name) + "_id"
+	var routes []resourceRoute
+
+	if c, ok := controller.(resourceLister); ok {
+		routes = append(routes, resourceRoute{[]string{fiber.MethodGet}, "/" + name, name + ".index", c.List})
+	}
+	if c, ok := controller.(resourceNewer); ok {
+		routes = append(routes, resourceRoute{[]string{fiber.MethodGet}, "/" + name + "/new", name + ".new", c.New})
+	}
+	if c, ok := controller.(resourceCreator); ok {
+		routes = append(routes, resourceRoute{[]string{fiber.MethodPost}, "/" + name, name + ".create", c.Create})
+	}
+	if c, ok := controller.(resourceShower); ok {
+		routes = append(routes, resourceRoute{[]string{fiber.MethodGet}, "/" + name + "/" + idParam, name + ".show", c.Show})
+	}
+	if c, ok := controller.(resourceEditor); ok {
+		routes = append(routes, resourceRoute{[]string{fiber.MethodGet}, "/" + name + "/" + idParam + "/edit", name + ".edit", c.Edit})
+	}
+	if c, ok := controller.(resourceUpdater); ok {
+		routes = append(routes, resourceRoute{[]string{fiber.MethodPatch, fiber.MethodPut}, "/" + name + "/" + idParam, name + ".update", c.Update})
+	}
+	if c, ok := controller.(resourceDestroyer); ok {
+		routes = append(routes, resourceRoute{[]string{fiber.MethodDelete}, "/" + name + "/" + idParam, name + ".destroy", c.Destroy})
+	}
+
+	return routes
+}
+
+// singularize applies a handful of English pluralization rules to turn
+// a resource name into the singular form used for its :id param, e.g.
+// "users" -> "user", "categories" -> "category", "boxes" -> "box". It's
+// not a general stemmer - just enough for typical REST resource names.
+func singularize(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "ses"), strings.HasSuffix(name, "xes"), strings.HasSuffix(name, "ches"), strings.HasSuffix(name, "shes"):
+		return strings.TrimSuffix(name, "es")
+	case strings.HasSuffix(name, "s"):
+		return strings.TrimSuffix(name, "s")
+	default:
+		return name
+	}
+}
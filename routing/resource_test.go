@@ -0,0 +1,119 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import (
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// fullResourceController implements every resource interface.
+type fullResourceController struct{}
+
+func (fullResourceController) List(fiber.Ctx) error    { return nil }
+func (fullResourceController) Show(fiber.Ctx) error    { return nil }
+func (fullResourceController) New(fiber.Ctx) error     { return nil }
+func (fullResourceController) Create(fiber.Ctx) error  { return nil }
+func (fullResourceController) Edit(fiber.Ctx) error    { return nil }
+func (fullResourceController) Update(fiber.Ctx) error  { return nil }
+func (fullResourceController) Destroy(fiber.Ctx) error { return nil }
+
+// partialResourceController only implements List and Show.
+type partialResourceController struct{}
+
+func (partialResourceController) List(fiber.Ctx) error { return nil }
+func (partialResourceController) Show(fiber.Ctx) error { return nil }
+
+func TestResourceRoutesFullController(t *testing.T) {
+	routes := resourceRoutes("users", fullResourceController{})
+
+	want := map[string][]string{
+		"users.index":   {fiber.MethodGet},
+		"users.new":     {fiber.MethodGet},
+		"users.create":  {fiber.MethodPost},
+		"users.show":    {fiber.MethodGet},
+		"users.edit":    {fiber.MethodGet},
+		"users.update":  {fiber.MethodPatch, fiber.MethodPut},
+		"users.destroy": {fiber.MethodDelete},
+	}
+	if len(routes) != len(want) {
+		t.Fatalf("got %d routes, want %d", len(routes), len(want))
+	}
+
+	paths := map[string]string{
+		"users.index":   "/users",
+		"users.new":     "/users/new",
+		"users.create":  "/users",
+		"users.show":    "/users/:user_id",
+		"users.edit":    "/users/:user_id/edit",
+		"users.update":  "/users/:user_id",
+		"users.destroy": "/users/:user_id",
+	}
+
+	seen := make(map[string]bool)
+	for _, route := range routes {
+		seen[route.name] = true
+		wantMethods, ok := want[route.name]
+		if !ok {
+			t.Errorf("unexpected route name %q", route.name)
+			continue
+		}
+		if !equalStrings(route.methods, wantMethods) {
+			t.Errorf("%s: got methods %v, want %v", route.name, route.methods, wantMethods)
+		}
+		if route.path != paths[route.name] {
+			t.Errorf("%s: got path %q, want %q", route.name, route.path, paths[route.name])
+		}
+		if route.handler == nil {
+			t.Errorf("%s: handler should not be nil", route.name)
+		}
+	}
+	for name := range want {
+		if !seen[name] {
+			t.Errorf("missing expected route %q", name)
+		}
+	}
+}
+
+func TestResourceRoutesPartialController(t *testing.T) {
+	routes := resourceRoutes("users", partialResourceController{})
+
+	if len(routes) != 2 {
+		t.Fatalf("got %d routes, want 2 (only List and Show are implemented)", len(routes))
+	}
+	names := map[string]bool{routes[0].name: true, routes[1].name: true}
+	if !names["users.index"] || !names["users.show"] {
+		t.Errorf("got route names %v, want only users.index and users.show", names)
+	}
+}
+
+func TestSingularize(t *testing.T) {
+	tests := []struct{ plural, want string }{
+		{"users", "user"},
+		{"categories", "category"},
+		{"boxes", "box"},
+		{"buses", "bus"},
+		{"watches", "watch"},
+		{"data", "data"},
+	}
+	for _, tt := range tests {
+		if got := singularize(tt.plural); got != tt.want {
+			t.Errorf("singularize(%q) = %q, want %q", tt.plural, got, tt.want)
+		}
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
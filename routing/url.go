@@ -0,0 +1,192 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// routeRegistry maps route names (as set via Group.Name once a route
+// exists) to the path they were registered under, so URL can
+// reverse the lookup. *fiber.App doesn't carry this itself, so one
+// registry is kept per app, keyed by the app pointer.
+type routeRegistry struct {
+	mu    sync.RWMutex
+	paths map[string]string
+}
+
+var routeRegistries sync.Map // map[*fiber.App[fiber.Router]]*routeRegistry
+
+func registryFor(app *fiber.App[fiber.Router]) *routeRegistry {
+	if existing, ok := routeRegistries.Load(app); ok {
+		return existing.(*routeRegistry)
+	}
+	reg := &routeRegistry{paths: make(map[string]string)}
+	actual, _ := routeRegistries.LoadOrStore(app, reg)
+	return actual.(*routeRegistry)
+}
+
+func (r *routeRegistry) register(name, path string) {
+	r.mu.Lock()
+	r.paths[name] = path
+	r.mu.Unlock()
+}
+
+func (r *routeRegistry) lookup(name string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	path, ok := r.paths[name]
+	return path, ok
+}
+
+// URL reconstructs the path for the route registered under name (via
+// Name, app-wide - not just within grp) by substituting its path
+// parameters from params. Any entry in params that doesn't correspond
+// to a path segment is appended as a query string; a required,
+// non-optional segment missing from params is reported as an error, as
+// is a param whose value doesn't satisfy the route segment's type
+// constraint (e.g. ":id<int>").
+//
+//	grp.Get("/:user_id", showUser).Name("users.show")
+//	grp.URL("users.show", fiber.Map{"user_id": 42, "expand": "profile"})
+//	// "/users/42?expand=profile", nil
+//
+// AppURL is the equivalent for callers that only have the *fiber.App,
+// not a Group.
+func (grp *Group) URL(name string, params fiber.Map) (string, error) {
+	return AppURL(grp.app, name, params)
+}
+
+// AppURL reconstructs a route's path the same way Group.URL does, for
+// callers without a Group at hand.
+func AppURL(app *fiber.App[fiber.Router], name string, params fiber.Map) (string, error) {
+	path, ok := registryFor(app).lookup(name)
+	if !ok {
+		return "", fmt.Errorf("fiber: no route registered with name %q", name)
+	}
+
+	return buildRouteURL(path, params)
+}
+
+var guidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// matchesParamConstraint reports whether value satisfies a route
+// segment's "<constraint>" suffix, e.g. ":id<int>". Unknown constraints
+// are treated as unconstrained, since param constraint syntax is
+// defined by the router, not by this reverse-lookup.
+func matchesParamConstraint(value, constraint string) bool {
+	switch constraint {
+	case "int":
+		_, err := strconv.Atoi(value)
+		return err == nil
+	case "bool":
+		_, err := strconv.ParseBool(value)
+		return err == nil
+	case "alpha":
+		if value == "" {
+			return false
+		}
+		for _, r := range value {
+			if !unicode.IsLetter(r) {
+				return false
+			}
+		}
+		return true
+	case "guid":
+		return guidPattern.MatchString(value)
+	default:
+		return true
+	}
+}
+
+// buildRouteURL substitutes path's ":param", ":param?", ":param<type>"
+// and "*" segments from params and appends whatever is left over as a
+// query string.
+func buildRouteURL(path string, params fiber.Map) (string, error) {
+	segments := strings.Split(path, "/")
+	used := make(map[string]bool, len(params))
+
+	for i, seg := range segments {
+		switch {
+		case seg == "*":
+			val, ok := params["*"]
+			if !ok {
+				return "", fmt.Errorf("fiber: missing value for wildcard segment in %q", path)
+			}
+			segments[i] = fmt.Sprintf("%v", val)
+			used["*"] = true
+
+		case strings.HasPrefix(seg, ":"):
+			body := strings.TrimSuffix(strings.TrimPrefix(seg, ":"), "?")
+			optional := strings.HasSuffix(seg, "?")
+
+			key, constraint := body, ""
+			if open := strings.IndexByte(body, '<'); open != -1 && strings.HasSuffix(body, ">") {
+				key, constraint = body[:open], body[open+1:len(body)-1]
+			}
+
+			val, ok := params[key]
+			if !ok {
+				if optional {
+					segments[i] = ""
+					continue
+				}
+				return "", fmt.Errorf("fiber: missing required param %q for %q", key, path)
+			}
+
+			str := fmt.Sprintf("%v", val)
+			if constraint != "" && !matchesParamConstraint(str, constraint) {
+				return "", fmt.Errorf("fiber: param %q value %q does not satisfy constraint %q for %q", key, str, constraint, path)
+			}
+			segments[i] = str
+			used[key] = true
+		}
+	}
+
+	built := joinURLSegments(segments)
+
+	query := make([]string, 0, len(params)-len(used))
+	for key, val := range params {
+		if used[key] {
+			continue
+		}
+		query = append(query, fmt.Sprintf("%s=%s", url.QueryEscape(key), url.QueryEscape(fmt.Sprintf("%v", val))))
+	}
+	sort.Strings(query)
+
+	if len(query) > 0 {
+		built += "?" + strings.Join(query, "&")
+	}
+
+	return built, nil
+}
+
+// joinURLSegments rejoins path segments with "/", dropping every empty
+// segment after the leading one - those left by missing optional params,
+// and any from a trailing or doubled slash - instead of collapsing
+// the resulting slash runs string-by-string - which breaks as soon as
+// two or more adjacent optional params are both missing.
+func joinURLSegments(segments []string) string {
+	kept := make([]string, 0, len(segments))
+	for i, seg := range segments {
+		if seg == "" && i != 0 {
+			continue
+		}
+		kept = append(kept, seg)
+	}
+	if len(kept) <= 1 {
+		return "/"
+	}
+	return strings.Join(kept, "/")
+}
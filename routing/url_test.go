@@ -0,0 +1,75 @@
+// ⚡️ Fiber is an Express inspired web framework written in Go with ☕️
+// 🤖 Github Repository: https://github.com/gofiber/fiber
+// 📌 API Documentation: https://docs.gofiber.io
+
+package routing
+
+import (
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestBuildRouteURLSubstitutesParams(t *testing.T) {
+	got, err := buildRouteURL("/users/:user_id", fiber.Map{"user_id": 42, "expand": "profile"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "/users/42?expand=profile" {
+		t.Errorf("got %q, want %q", got, "/users/42?expand=profile")
+	}
+}
+
+func TestBuildRouteURLMissingRequiredParam(t *testing.T) {
+	if _, err := buildRouteURL("/users/:user_id", fiber.Map{}); err == nil {
+		t.Error("expected an error for a missing required param, got nil")
+	}
+}
+
+func TestBuildRouteURLAdjacentMissingOptionalParams(t *testing.T) {
+	got, err := buildRouteURL("/a/:x?/:y?/b", fiber.Map{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "/a/b" {
+		t.Errorf("got %q, want %q", got, "/a/b")
+	}
+}
+
+func TestBuildRouteURLSingleMissingOptionalParam(t *testing.T) {
+	got, err := buildRouteURL("/a/:x?", fiber.Map{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "/a" {
+		t.Errorf("got %q, want %q", got, "/a")
+	}
+}
+
+func TestBuildRouteURLTypeConstraint(t *testing.T) {
+	if _, err := buildRouteURL("/users/:user_id<int>", fiber.Map{"user_id": "abc"}); err == nil {
+		t.Error("expected an error for a param that fails its <int> constraint, got nil")
+	}
+
+	got, err := buildRouteURL("/users/:user_id<int>", fiber.Map{"user_id": 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "/users/7" {
+		t.Errorf("got %q, want %q", got, "/users/7")
+	}
+}
+
+func TestRouteRegistryRoundTrip(t *testing.T) {
+	app := &fiber.App[fiber.Router]{}
+	registryFor(app).register("users.show", "/users/:user_id")
+
+	path, ok := registryFor(app).lookup("users.show")
+	if !ok || path != "/users/:user_id" {
+		t.Errorf("got (%q, %v), want (%q, true)", path, ok, "/users/:user_id")
+	}
+
+	if _, err := AppURL(app, "does.not.exist", fiber.Map{}); err == nil {
+		t.Error("expected an error for an unregistered route name, got nil")
+	}
+}